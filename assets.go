@@ -0,0 +1,66 @@
+package main
+
+import (
+	"embed"
+	"io/fs"
+	"log"
+	"os"
+)
+
+// devAssets mirrors the -dev flag: when true, cssFS/iconsFS/htmlFS serve
+// straight from disk instead of the embedded snapshot below, so edits
+// under public/ and html/ (including devserver's reconverted pages)
+// show up on refresh without a rebuild. main sets this once at startup,
+// the same way it sets layout.DevMode, so a single -dev flag controls
+// both the live-reload watcher and which assets it's reloading.
+var devAssets bool
+
+// Static assets and converted Markdown pages are also embedded into the
+// binary, so a production `go build` (devAssets always false) produces
+// a self-contained binary with no runtime dependency on the working
+// directory layout.
+
+//go:embed public/css
+var embeddedCSS embed.FS
+
+//go:embed public/icons
+var embeddedIcons embed.FS
+
+// html/.gitkeep ensures this embed always matches at least one file even
+// before the converter in temp/ has written converted pages into it.
+//
+//go:embed all:html
+var embeddedHTML embed.FS
+
+func cssFS() fs.FS {
+	if devAssets {
+		return os.DirFS("./public/css")
+	}
+	sub, err := fs.Sub(embeddedCSS, "public/css")
+	if err != nil {
+		log.Fatalf("assets: sub css: %v", err)
+	}
+	return sub
+}
+
+func iconsFS() fs.FS {
+	if devAssets {
+		return os.DirFS("./public/icons")
+	}
+	sub, err := fs.Sub(embeddedIcons, "public/icons")
+	if err != nil {
+		log.Fatalf("assets: sub icons: %v", err)
+	}
+	return sub
+}
+
+func htmlFS() fs.FS {
+	if devAssets {
+		return os.DirFS("./html")
+	}
+	sub, err := fs.Sub(embeddedHTML, "html")
+	if err != nil {
+		log.Fatalf("assets: sub html: %v", err)
+	}
+	return sub
+}
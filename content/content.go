@@ -0,0 +1,140 @@
+// Package content builds the site's page manifest — the ordered list of
+// tutorial pages, their navigation, and the routes derived from it —
+// from templates/pages' self-registered Registry, so adding a lesson is
+// a one-file change: a new .templ file that calls pages.Register in its
+// own init(). content.yaml is optional and only needed to pin a page's
+// title or its place in the reading order.
+package content
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/a-h/templ"
+	"gopkg.in/yaml.v3"
+
+	"github.com/whalelogic/howtogo/templates/layout"
+	"github.com/whalelogic/howtogo/templates/pages"
+)
+
+// Page describes one routable tutorial page and its place in the
+// tutorial's reading order.
+type Page struct {
+	Slug      string
+	Title     string
+	Order     int
+	Prev      *Page
+	Next      *Page
+	Component templ.Component
+}
+
+// Path returns the route this page is served from.
+func (p Page) Path() string {
+	if p.Slug == "" {
+		return "/"
+	}
+	return "/" + p.Slug
+}
+
+// PagerPrev and PagerNext adapt a Page's navigation to layout.PagerLink,
+// returning nil when there is nothing to link to.
+func (p Page) PagerPrev() *layout.PagerLink {
+	if p.Prev == nil {
+		return nil
+	}
+	return &layout.PagerLink{Href: p.Prev.Path(), Title: p.Prev.Title}
+}
+
+func (p Page) PagerNext() *layout.PagerLink {
+	if p.Next == nil {
+		return nil
+	}
+	return &layout.PagerLink{Href: p.Next.Path(), Title: p.Next.Title}
+}
+
+// entry is the on-disk shape of a single content.yaml item. Title is an
+// optional override of the page's self-registered title; Order controls
+// where it falls in the reading order.
+type entry struct {
+	Slug  string `yaml:"slug"`
+	Title string `yaml:"title"`
+	Order int    `yaml:"order"`
+}
+
+// Load builds the page manifest from pages.Registry. path (typically
+// content.yaml) is optional: listing a slug there pins its title and
+// reading-order position; any registered page missing from path is
+// appended afterwards, ordered alphabetically by slug, so it still
+// routes and shows up in navigation without path needing an update.
+func Load(path string) ([]Page, error) {
+	entries, err := readEntries(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pageList := make([]Page, 0, len(pages.Registry))
+	seen := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		reg, ok := pages.Registry[e.Slug]
+		if !ok {
+			return nil, fmt.Errorf("content: %s lists slug %q with no registered page", path, e.Slug)
+		}
+		title := e.Title
+		if title == "" {
+			title = reg.Title
+		}
+		pageList = append(pageList, Page{Slug: e.Slug, Title: title, Order: e.Order, Component: reg.Component})
+		seen[e.Slug] = true
+	}
+
+	extra := make([]string, 0, len(pages.Registry)-len(seen))
+	for slug := range pages.Registry {
+		if !seen[slug] {
+			extra = append(extra, slug)
+		}
+	}
+	sort.Strings(extra)
+	next := 0
+	for _, e := range entries {
+		if e.Order+1 > next {
+			next = e.Order + 1
+		}
+	}
+	for i, slug := range extra {
+		reg := pages.Registry[slug]
+		pageList = append(pageList, Page{
+			Slug:      slug,
+			Title:     reg.Title,
+			Order:     next + i,
+			Component: reg.Component,
+		})
+	}
+
+	sort.SliceStable(pageList, func(i, j int) bool { return pageList[i].Order < pageList[j].Order })
+	for i := range pageList {
+		if i > 0 {
+			pageList[i].Prev = &pageList[i-1]
+		}
+		if i < len(pageList)-1 {
+			pageList[i].Next = &pageList[i+1]
+		}
+	}
+	return pageList, nil
+}
+
+func readEntries(path string) ([]entry, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("content: reading %s: %w", path, err)
+	}
+
+	var entries []entry
+	if err := yaml.Unmarshal(raw, &entries); err != nil {
+		return nil, fmt.Errorf("content: parsing %s: %w", path, err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,60 @@
+package content
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Summary is the JSON-serializable projection of a Page, used by the
+// /api/pages index.
+type Summary struct {
+	Slug  string `json:"slug"`
+	Title string `json:"title"`
+	Order int    `json:"order"`
+	Path  string `json:"path"`
+	Prev  string `json:"prev,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+func (p Page) summary() Summary {
+	s := Summary{Slug: p.Slug, Title: p.Title, Order: p.Order, Path: p.Path()}
+	if p.Prev != nil {
+		s.Prev = p.Prev.Path()
+	}
+	if p.Next != nil {
+		s.Next = p.Next.Path()
+	}
+	return s
+}
+
+// PagesAPIHandler serves the manifest as a JSON index at /api/pages.
+func PagesAPIHandler(pages []Page) gin.HandlerFunc {
+	summaries := make([]Summary, len(pages))
+	for i, p := range pages {
+		summaries[i] = p.summary()
+	}
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, summaries)
+	}
+}
+
+// SitemapHandler serves a minimal /sitemap.xml built from the manifest,
+// with baseURL (e.g. "https://howtogo.example.com") prefixed to each
+// page's path.
+func SitemapHandler(pages []Page, baseURL string) gin.HandlerFunc {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	b.WriteString(`<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">` + "\n")
+	for _, p := range pages {
+		fmt.Fprintf(&b, "  <url><loc>%s%s</loc></url>\n", baseURL, p.Path())
+	}
+	b.WriteString("</urlset>\n")
+	body := b.String()
+
+	return func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/xml; charset=utf-8", []byte(body))
+	}
+}
@@ -0,0 +1,45 @@
+// Package converter renders Markdown source files into HTML pages for the
+// site. It replaces the previous shell-out to the pandoc binary with an
+// in-process pipeline built on goldmark, while keeping pandoc available as
+// an opt-in Converter for content that relies on its extensions.
+package converter
+
+import "fmt"
+
+// PageMeta holds the front-matter metadata extracted from a Markdown
+// source file, plus any defaults the caller seeded it with (e.g. a Slug
+// derived from the filename).
+type PageMeta struct {
+	Title string
+	Slug  string
+	Order int
+	Tags  []string
+}
+
+// Converter turns Markdown source bytes into rendered HTML, populating
+// meta with any front-matter it discovers along the way.
+type Converter interface {
+	Convert(src []byte, meta *PageMeta) ([]byte, error)
+}
+
+// registry holds the named Converter constructors selected by the
+// -converter flag. goldmark registers itself as the default in
+// goldmark.go; pandoc registers itself in pandoc.go.
+var registry = map[string]func() Converter{}
+
+func register(name string, fn func() Converter) {
+	registry[name] = fn
+}
+
+// New returns the Converter registered under name, defaulting to goldmark
+// when name is empty.
+func New(name string) (Converter, error) {
+	if name == "" {
+		name = "goldmark"
+	}
+	fn, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("converter: unknown converter %q", name)
+	}
+	return fn(), nil
+}
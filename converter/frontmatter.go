@@ -0,0 +1,74 @@
+package converter
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// splitFrontMatter separates a leading --- (YAML) or +++ (TOML) front
+// matter block from the remaining Markdown body, returning the raw
+// front-matter bytes (delimiters stripped), the body, and the detected
+// format. format is "" when src has no recognisable front matter.
+func splitFrontMatter(src []byte) (raw, body []byte, format string) {
+	for _, d := range []struct {
+		delim  []byte
+		format string
+	}{
+		{[]byte("---"), "yaml"},
+		{[]byte("+++"), "toml"},
+	} {
+		if !bytes.HasPrefix(src, d.delim) {
+			continue
+		}
+		rest := src[len(d.delim):]
+		if i := bytes.Index(rest, d.delim); i >= 0 {
+			return bytes.TrimSpace(rest[:i]), bytes.TrimSpace(rest[i+len(d.delim):]), d.format
+		}
+	}
+	return nil, src, ""
+}
+
+// parseFrontMatter decodes a leading front-matter block into meta,
+// overriding only the fields the front matter sets, and returns the
+// remaining Markdown body.
+func parseFrontMatter(src []byte, meta *PageMeta) ([]byte, error) {
+	raw, body, format := splitFrontMatter(src)
+	if format == "" {
+		return body, nil
+	}
+
+	var fm struct {
+		Title string   `yaml:"title" toml:"title"`
+		Slug  string   `yaml:"slug" toml:"slug"`
+		Order int      `yaml:"order" toml:"order"`
+		Tags  []string `yaml:"tags" toml:"tags"`
+	}
+
+	switch format {
+	case "yaml":
+		if err := yaml.Unmarshal(raw, &fm); err != nil {
+			return nil, fmt.Errorf("converter: parsing YAML front matter: %w", err)
+		}
+	case "toml":
+		if err := toml.Unmarshal(raw, &fm); err != nil {
+			return nil, fmt.Errorf("converter: parsing TOML front matter: %w", err)
+		}
+	}
+
+	if fm.Title != "" {
+		meta.Title = fm.Title
+	}
+	if fm.Slug != "" {
+		meta.Slug = fm.Slug
+	}
+	if fm.Order != 0 {
+		meta.Order = fm.Order
+	}
+	if len(fm.Tags) > 0 {
+		meta.Tags = fm.Tags
+	}
+	return body, nil
+}
@@ -0,0 +1,65 @@
+package converter
+
+import "testing"
+
+func TestParseFrontMatterYAML(t *testing.T) {
+	src := []byte("---\ntitle: Custom Title\nslug: custom\norder: 3\ntags: [go, basics]\n---\n# Body\n")
+
+	meta := &PageMeta{Title: "fallback"}
+	body, err := parseFrontMatter(src, meta)
+	if err != nil {
+		t.Fatalf("parseFrontMatter: %v", err)
+	}
+
+	if meta.Title != "Custom Title" {
+		t.Errorf("Title = %q, want %q", meta.Title, "Custom Title")
+	}
+	if meta.Slug != "custom" {
+		t.Errorf("Slug = %q, want %q", meta.Slug, "custom")
+	}
+	if meta.Order != 3 {
+		t.Errorf("Order = %d, want 3", meta.Order)
+	}
+	if len(meta.Tags) != 2 || meta.Tags[0] != "go" || meta.Tags[1] != "basics" {
+		t.Errorf("Tags = %v, want [go basics]", meta.Tags)
+	}
+	if string(body) != "# Body" {
+		t.Errorf("body = %q, want %q", body, "# Body")
+	}
+}
+
+func TestParseFrontMatterTOML(t *testing.T) {
+	src := []byte("+++\ntitle = \"TOML Title\"\norder = 1\n+++\n# Body\n")
+
+	meta := &PageMeta{}
+	body, err := parseFrontMatter(src, meta)
+	if err != nil {
+		t.Fatalf("parseFrontMatter: %v", err)
+	}
+
+	if meta.Title != "TOML Title" {
+		t.Errorf("Title = %q, want %q", meta.Title, "TOML Title")
+	}
+	if meta.Order != 1 {
+		t.Errorf("Order = %d, want 1", meta.Order)
+	}
+	if string(body) != "# Body" {
+		t.Errorf("body = %q, want %q", body, "# Body")
+	}
+}
+
+func TestParseFrontMatterNone(t *testing.T) {
+	src := []byte("# Just Markdown\n")
+
+	meta := &PageMeta{Title: "kept"}
+	body, err := parseFrontMatter(src, meta)
+	if err != nil {
+		t.Fatalf("parseFrontMatter: %v", err)
+	}
+	if meta.Title != "kept" {
+		t.Errorf("Title = %q, want unchanged %q", meta.Title, "kept")
+	}
+	if string(body) != string(src) {
+		t.Errorf("body = %q, want unchanged input", body)
+	}
+}
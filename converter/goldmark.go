@@ -0,0 +1,63 @@
+package converter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	ghtml "github.com/yuin/goldmark/renderer/html"
+
+	"github.com/a-h/templ"
+	"github.com/whalelogic/howtogo/templates/layout"
+)
+
+func init() {
+	register("goldmark", newGoldmarkConverter)
+}
+
+// goldmarkConverter renders Markdown with goldmark, highlights fenced Go
+// (and other) code blocks with chroma, and wraps the resulting fragment
+// in the site's templ layout so the output is a full page consistent
+// with the hand-written Gin routes.
+type goldmarkConverter struct {
+	md goldmark.Markdown
+}
+
+func newGoldmarkConverter() Converter {
+	return &goldmarkConverter{
+		md: goldmark.New(
+			goldmark.WithExtensions(
+				extension.GFM,
+				highlighting.NewHighlighting(
+					highlighting.WithFormatOptions(chromahtml.WithClasses(true)),
+				),
+			),
+			goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+			goldmark.WithRendererOptions(ghtml.WithUnsafe()),
+		),
+	}
+}
+
+func (c *goldmarkConverter) Convert(src []byte, meta *PageMeta) ([]byte, error) {
+	body, err := parseFrontMatter(src, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	var fragment bytes.Buffer
+	if err := c.md.Convert(body, &fragment); err != nil {
+		return nil, fmt.Errorf("converter: rendering markdown: %w", err)
+	}
+
+	ctx := templ.WithChildren(context.Background(), templ.Raw(fragment.String()))
+	var page bytes.Buffer
+	if err := layout.Page(meta.Title).Render(ctx, &page); err != nil {
+		return nil, fmt.Errorf("converter: wrapping layout: %w", err)
+	}
+	return page.Bytes(), nil
+}
@@ -0,0 +1,61 @@
+package converter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/a-h/templ"
+
+	"github.com/whalelogic/howtogo/templates/layout"
+)
+
+func init() {
+	register("pandoc", newPandocConverter)
+}
+
+// pandocConverter shells out to the pandoc binary. It is kept as an
+// opt-in Converter, selected with -converter=pandoc, for content that
+// relies on pandoc-specific extensions goldmark does not support.
+type pandocConverter struct{}
+
+func newPandocConverter() Converter {
+	return &pandocConverter{}
+}
+
+func (c *pandocConverter) Convert(src []byte, meta *PageMeta) ([]byte, error) {
+	body, err := parseFrontMatter(src, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := os.CreateTemp("", "howtogo-pandoc-*.md")
+	if err != nil {
+		return nil, fmt.Errorf("converter: creating temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(body); err != nil {
+		tmp.Close()
+		return nil, fmt.Errorf("converter: writing temp file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, fmt.Errorf("converter: closing temp file: %w", err)
+	}
+
+	var out bytes.Buffer
+	cmd := exec.Command("pandoc", tmp.Name())
+	cmd.Stdout = &out
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("converter: running pandoc: %w", err)
+	}
+
+	ctx := templ.WithChildren(context.Background(), templ.Raw(out.String()))
+	var page bytes.Buffer
+	if err := layout.Page(meta.Title).Render(ctx, &page); err != nil {
+		return nil, fmt.Errorf("converter: wrapping layout: %w", err)
+	}
+	return page.Bytes(), nil
+}
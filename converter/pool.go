@@ -0,0 +1,107 @@
+package converter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Job describes a single Markdown source file to convert.
+type Job struct {
+	Src string
+	Dst string
+}
+
+// Result is the outcome of converting a single Job.
+type Result struct {
+	Job  Job
+	Meta PageMeta
+	Err  error
+}
+
+// WalkDir finds every .md file directly under dir and returns the Jobs
+// needed to render them into outDir as sibling .html files.
+func WalkDir(dir, outDir string) ([]Job, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("converter: reading %s: %w", dir, err)
+	}
+
+	var jobs []Job
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".md") {
+			continue
+		}
+		dst := strings.TrimSuffix(e.Name(), ".md") + ".html"
+		jobs = append(jobs, Job{
+			Src: filepath.Join(dir, e.Name()),
+			Dst: filepath.Join(outDir, dst),
+		})
+	}
+	return jobs, nil
+}
+
+// Run converts jobs concurrently using up to workers goroutines and
+// writes each result to its Dst path as it completes.
+func Run(conv Converter, jobs []Job, workers int) []Result {
+	if workers < 1 {
+		workers = 1
+	}
+
+	in := make(chan Job)
+	out := make(chan Result)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for job := range in {
+				out <- convertOne(conv, job)
+			}
+		}()
+	}
+
+	go func() {
+		for _, j := range jobs {
+			in <- j
+		}
+		close(in)
+	}()
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	results := make([]Result, 0, len(jobs))
+	for r := range out {
+		results = append(results, r)
+	}
+	return results
+}
+
+func convertOne(conv Converter, job Job) Result {
+	src, err := os.ReadFile(job.Src)
+	if err != nil {
+		return Result{Job: job, Err: fmt.Errorf("converter: reading %s: %w", job.Src, err)}
+	}
+
+	base := strings.TrimSuffix(filepath.Base(job.Src), ".md")
+	meta := PageMeta{Slug: base, Title: base}
+
+	rendered, err := conv.Convert(src, &meta)
+	if err != nil {
+		return Result{Job: job, Meta: meta, Err: err}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(job.Dst), 0o755); err != nil {
+		return Result{Job: job, Meta: meta, Err: fmt.Errorf("converter: creating %s: %w", filepath.Dir(job.Dst), err)}
+	}
+	if err := os.WriteFile(job.Dst, rendered, 0o644); err != nil {
+		return Result{Job: job, Meta: meta, Err: fmt.Errorf("converter: writing %s: %w", job.Dst, err)}
+	}
+	return Result{Job: job, Meta: meta}
+}
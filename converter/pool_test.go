@@ -0,0 +1,70 @@
+package converter
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// upperConverter is a stub Converter used to exercise WalkDir/Run
+// without depending on goldmark or the templ layout.
+type upperConverter struct{}
+
+func (upperConverter) Convert(src []byte, meta *PageMeta) ([]byte, error) {
+	return []byte(strings.ToUpper(string(src))), nil
+}
+
+func TestWalkDirFindsMarkdownOnly(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.md", "b.md", "c.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0o644); err != nil {
+			t.Fatalf("writing %s: %v", name, err)
+		}
+	}
+
+	jobs, err := WalkDir(dir, filepath.Join(dir, "out"))
+	if err != nil {
+		t.Fatalf("WalkDir: %v", err)
+	}
+	if len(jobs) != 2 {
+		t.Fatalf("len(jobs) = %d, want 2", len(jobs))
+	}
+}
+
+func TestRunConvertsEveryJob(t *testing.T) {
+	dir := t.TempDir()
+	outDir := filepath.Join(dir, "out")
+
+	want := map[string]string{"a.md": "HELLO", "b.md": "WORLD"}
+	for name, body := range want {
+		src := strings.ToLower(body)
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatalf("writing %s: %v", name, err)
+		}
+	}
+
+	jobs, err := WalkDir(dir, outDir)
+	if err != nil {
+		t.Fatalf("WalkDir: %v", err)
+	}
+
+	results := Run(upperConverter{}, jobs, 4)
+	if len(results) != len(jobs) {
+		t.Fatalf("len(results) = %d, want %d", len(results), len(jobs))
+	}
+
+	for _, r := range results {
+		if r.Err != nil {
+			t.Fatalf("converting %s: %v", r.Job.Src, r.Err)
+		}
+		got, err := os.ReadFile(r.Job.Dst)
+		if err != nil {
+			t.Fatalf("reading %s: %v", r.Job.Dst, err)
+		}
+		name := filepath.Base(r.Job.Src)
+		if string(got) != want[name] {
+			t.Errorf("%s = %q, want %q", name, got, want[name])
+		}
+	}
+}
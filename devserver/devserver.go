@@ -0,0 +1,42 @@
+package devserver
+
+import (
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/whalelogic/howtogo/converter"
+)
+
+// Config configures dev mode: which directories to watch, where
+// Markdown source and rendered HTML live, and which Converter to
+// reconvert changed files with.
+type Config struct {
+	WatchDirs []string
+	SrcDir    string
+	OutDir    string
+	Converter converter.Converter
+}
+
+// Register wires a websocket reload endpoint at /_dev/reload and starts
+// watching cfg.WatchDirs, reconverting Markdown and broadcasting a
+// reload to every connected browser whenever a watched file changes.
+func Register(r *gin.Engine, cfg Config) error {
+	hub := NewHub()
+	r.GET("/_dev/reload", hub.Handler())
+
+	onChange := func() {
+		jobs, err := converter.WalkDir(cfg.SrcDir, cfg.OutDir)
+		if err != nil {
+			log.Printf("devserver: walking %s: %v", cfg.SrcDir, err)
+		} else {
+			converter.Run(cfg.Converter, jobs, 4)
+		}
+		log.Println("devserver: change detected, reloading browsers")
+		hub.Broadcast()
+	}
+
+	_, err := Watch(cfg.WatchDirs, cfg.OutDir, 200*time.Millisecond, onChange)
+	return err
+}
@@ -0,0 +1,73 @@
+package devserver
+
+import (
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// Hub tracks connected /_dev/reload websocket clients and broadcasts a
+// reload signal to all of them whenever watched content changes.
+type Hub struct {
+	upgrader websocket.Upgrader
+
+	mu      sync.Mutex
+	clients map[*websocket.Conn]struct{}
+}
+
+// NewHub returns an empty Hub.
+func NewHub() *Hub {
+	return &Hub{
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin:     func(r *http.Request) bool { return true },
+		},
+		clients: make(map[*websocket.Conn]struct{}),
+	}
+}
+
+// Handler upgrades the request to a websocket connection and registers
+// it as a reload target until the client disconnects.
+func (h *Hub) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			log.Printf("devserver: upgrade: %v", err)
+			return
+		}
+
+		h.mu.Lock()
+		h.clients[conn] = struct{}{}
+		h.mu.Unlock()
+
+		defer func() {
+			h.mu.Lock()
+			delete(h.clients, conn)
+			h.mu.Unlock()
+			conn.Close()
+		}()
+
+		// Reloads are pushed by Broadcast; block here only to detect
+		// the client going away.
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Broadcast pushes a reload message to every connected client.
+func (h *Hub) Broadcast() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for conn := range h.clients {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte("reload")); err != nil {
+			log.Printf("devserver: broadcasting: %v", err)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Package devserver implements the site's live-reload development mode:
+// watching the templates/, public/, and Markdown source directories for
+// changes, reconverting Markdown through the converter package, and
+// pushing a reload signal to connected browsers over a websocket.
+package devserver
+
+import (
+	"fmt"
+	"io/fs"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Watch recursively watches dirs and calls onChange, debounced so that a
+// burst of filesystem events (an editor's save-and-rewrite, for example)
+// triggers at most one call per debounce window. Any directory at or
+// under exclude is skipped, so watching a source tree that also
+// contains onChange's own generated output (e.g. the Markdown build's
+// html/ directory) doesn't retrigger itself in a loop. exclude may be
+// "" to watch everything. Dotdirs (.git and the like) are always
+// skipped, so unrelated VCS activity under a watched root doesn't
+// trigger onChange either.
+func Watch(dirs []string, exclude string, debounce time.Duration, onChange func()) (*fsnotify.Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("devserver: creating watcher: %w", err)
+	}
+
+	exclude = filepath.Clean(exclude)
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if path != dir && strings.HasPrefix(d.Name(), ".") {
+					return filepath.SkipDir
+				}
+				if exclude != "." {
+					clean := filepath.Clean(path)
+					if clean == exclude || strings.HasPrefix(clean, exclude+string(filepath.Separator)) {
+						return filepath.SkipDir
+					}
+				}
+				return watcher.Add(path)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("devserver: watching %s: %v", dir, err)
+		}
+	}
+
+	go func() {
+		var timer *time.Timer
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove|fsnotify.Rename) == 0 {
+					continue
+				}
+				if timer != nil {
+					timer.Stop()
+				}
+				timer = time.AfterFunc(debounce, onChange)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Printf("devserver: watch error: %v", err)
+			}
+		}
+	}()
+
+	return watcher, nil
+}
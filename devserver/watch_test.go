@@ -0,0 +1,66 @@
+package devserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatchSkipsExcludedDir(t *testing.T) {
+	dir := t.TempDir()
+	outDir := filepath.Join(dir, "html")
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		t.Fatalf("mkdir %s: %v", outDir, err)
+	}
+
+	changes := make(chan struct{}, 8)
+	watcher, err := Watch([]string{dir}, outDir, 20*time.Millisecond, func() {
+		changes <- struct{}{}
+	})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	defer watcher.Close()
+
+	// A write under the excluded output directory must not trigger
+	// onChange, or a converter writing into it would reconvert forever.
+	if err := os.WriteFile(filepath.Join(outDir, "values.html"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("writing to excluded dir: %v", err)
+	}
+
+	select {
+	case <-changes:
+		t.Fatal("onChange fired for a write under the excluded directory")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestWatchSkipsDotDirs(t *testing.T) {
+	dir := t.TempDir()
+	gitDir := filepath.Join(dir, ".git")
+	if err := os.MkdirAll(gitDir, 0o755); err != nil {
+		t.Fatalf("mkdir %s: %v", gitDir, err)
+	}
+
+	changes := make(chan struct{}, 8)
+	watcher, err := Watch([]string{dir}, "", 20*time.Millisecond, func() {
+		changes <- struct{}{}
+	})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	defer watcher.Close()
+
+	// A write under .git (ordinary git activity from an editor or a
+	// commit) must not trigger onChange.
+	if err := os.WriteFile(filepath.Join(gitDir, "HEAD"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("writing to .git: %v", err)
+	}
+
+	select {
+	case <-changes:
+		t.Fatal("onChange fired for a write under a dotdir")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -1,62 +1,113 @@
 package main
 
 import (
+	"bytes"
+	"flag"
 	"log"
 	"net/http"
 
 	"github.com/a-h/templ"
 	"github.com/gin-gonic/gin"
 
-	"github.com/whalelogic/howtogo/templates/pages"
+	"github.com/whalelogic/howtogo/content"
+	"github.com/whalelogic/howtogo/converter"
+	"github.com/whalelogic/howtogo/devserver"
+	"github.com/whalelogic/howtogo/middleware"
+	"github.com/whalelogic/howtogo/playground"
+	"github.com/whalelogic/howtogo/templates/layout"
 )
 
+// render buffers component's output before writing it to the client, so
+// a Render failure can fall back to the error page cleanly instead of
+// leaving the client with a half-written response.
 func render(c *gin.Context, status int, component templ.Component) {
+	var buf bytes.Buffer
+	if err := component.Render(c.Request.Context(), &buf); err != nil {
+		log.Printf("render error [%s]: %v", middleware.RequestIDFrom(c), err)
+		middleware.ErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
 	c.Status(status)
-	if err := component.Render(c.Request.Context(), c.Writer); err != nil {
-		// Surface render errors to logs while keeping response simple.
-		log.Printf("render error: %v", err)
+	c.Writer.Write(buf.Bytes())
+}
+
+// renderPage renders a content page's component followed by its Prev/Next
+// pager, so the manifest's navigation shows up on every tutorial page.
+func renderPage(c *gin.Context, page content.Page) {
+	var buf bytes.Buffer
+	if err := page.Component.Render(c.Request.Context(), &buf); err != nil {
+		log.Printf("render error [%s]: %v", middleware.RequestIDFrom(c), err)
+		middleware.ErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	pager := layout.Pager(page.PagerPrev(), page.PagerNext())
+	if err := pager.Render(c.Request.Context(), &buf); err != nil {
+		log.Printf("render error [%s]: %v", middleware.RequestIDFrom(c), err)
+		middleware.ErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
 	}
+
+	c.Status(http.StatusOK)
+	c.Writer.Write(buf.Bytes())
 }
 
 func main() {
-	r := gin.Default()
+	dev := flag.Bool("dev", false, "run in development mode: serve assets from disk and live-reload on change")
+	baseURL := flag.String("base-url", "http://localhost:8080", "public origin this site is served from, used for absolute sitemap.xml URLs")
+	flag.Parse()
+
+	layout.DevMode = *dev
+	devAssets = *dev
+
+	r := gin.New()
+	r.Use(gin.Logger())
+	r.Use(middleware.RequestID())
+	r.Use(middleware.Recovery())
 	if err := r.SetTrustedProxies(nil); err != nil {
 		log.Fatalf("failed to set trusted proxies: %v", err)
 	}
+	r.NoRoute(middleware.NotFound())
 
-	r.Static("/css", "./public/css")
-	r.Static("/icons", "./public/icons")
+	r.StaticFS("/css", http.FS(cssFS()))
+	r.StaticFS("/icons", http.FS(iconsFS()))
+	r.StaticFS("/articles", http.FS(htmlFS()))
 
 	r.GET("/health", func(c *gin.Context) {
 		c.String(http.StatusOK, "200 OK\n")
 	})
 
-	// test component
-
-	component := pages.HelloWorld()
-	r.GET("/test", func(c *gin.Context) {
-		render(c, http.StatusOK, component)
-	})
-
-	r.GET("/", func(c *gin.Context) {
-		render(c, http.StatusOK, pages.Index())
-	})
-
-	r.GET("/hello-world", func(c *gin.Context) {
-		render(c, http.StatusOK, pages.HelloWorld())
-	})
+	pageList, err := content.Load("content.yaml")
+	if err != nil {
+		log.Fatalf("failed to load content manifest: %v", err)
+	}
 
-	r.GET("/values", func(c *gin.Context) {
-		render(c, http.StatusOK, pages.Values())
-	})
+	for _, page := range pageList {
+		page := page
+		r.GET(page.Path(), func(c *gin.Context) {
+			renderPage(c, page)
+		})
+	}
 
-	r.GET("/variables", func(c *gin.Context) {
-		render(c, http.StatusOK, pages.Variables())
-	})
+	r.GET("/sitemap.xml", content.SitemapHandler(pageList, *baseURL))
+	r.GET("/api/pages", content.PagesAPIHandler(pageList))
+	r.POST("/api/run", playground.Handler())
 
-	r.GET("/constants", func(c *gin.Context) {
-		render(c, http.StatusOK, pages.Constants())
-	})
+	if *dev {
+		conv, err := converter.New("goldmark")
+		if err != nil {
+			log.Fatalf("failed to build dev converter: %v", err)
+		}
+		err = devserver.Register(r, devserver.Config{
+			WatchDirs: []string{"templates", "public", "."},
+			SrcDir:    ".",
+			OutDir:    "html",
+			Converter: conv,
+		})
+		if err != nil {
+			log.Fatalf("failed to start dev watcher: %v", err)
+		}
+	}
 
 	if err := r.Run(":8080"); err != nil {
 		log.Fatalf("server failed: %v", err)
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/whalelogic/howtogo/templates/pages"
+)
+
+// ErrorResponse renders a typed error page for status, or a JSON error
+// shape ({code, message, request_id}) when the client sent
+// Accept: application/json, so the site can serve both HTML and API
+// errors uniformly.
+func ErrorResponse(c *gin.Context, status int, message string) {
+	requestID := RequestIDFrom(c)
+
+	if strings.Contains(c.GetHeader("Accept"), "application/json") {
+		c.AbortWithStatusJSON(status, gin.H{
+			"code":       status,
+			"message":    message,
+			"request_id": requestID,
+		})
+		return
+	}
+
+	c.Status(status)
+	component := pages.Error(status, requestID, message)
+	if err := component.Render(c.Request.Context(), c.Writer); err != nil {
+		// Last resort: we couldn't even render the error page.
+		c.String(status, "%d %s\n", status, message)
+	}
+	c.Abort()
+}
+
+// NotFound handles unmatched routes as a 404 through ErrorResponse.
+func NotFound() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ErrorResponse(c, http.StatusNotFound, "page not found")
+	}
+}
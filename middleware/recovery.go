@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Recovery recovers panics in downstream handlers and renders them as a
+// 500 through ErrorResponse, instead of letting Gin's default recovery
+// return a bare stack trace.
+func Recovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				log.Printf("panic recovered [%s]: %v", RequestIDFrom(c), rec)
+				ErrorResponse(c, http.StatusInternalServerError, "internal server error")
+			}
+		}()
+		c.Next()
+	}
+}
@@ -0,0 +1,50 @@
+// Package middleware provides Gin middleware shared across the site:
+// per-request IDs, panic recovery, and a unified HTML/JSON error
+// response used by both.
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDHeader is the response header RequestID sets on every request.
+const RequestIDHeader = "X-Request-Id"
+
+const requestIDKey = "request_id"
+
+// RequestID assigns a unique X-Request-Id to every request, reusing an
+// inbound header if the caller already set one (e.g. a load balancer),
+// and stores it in the Gin context for handlers and error pages to read
+// back with RequestIDFrom.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if id == "" {
+			id = newRequestID()
+		}
+		c.Set(requestIDKey, id)
+		c.Header(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+// RequestIDFrom returns the request ID assigned by RequestID, or "" if
+// the middleware was not installed.
+func RequestIDFrom(c *gin.Context) string {
+	id, _ := c.Get(requestIDKey)
+	s, _ := id.(string)
+	return s
+}
+
+func newRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b[:])
+}
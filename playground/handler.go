@@ -0,0 +1,44 @@
+package playground
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+)
+
+// maxBodyBytes caps how much of a request body Handler will read before
+// binding. A tutorial snippet is a few hundred bytes at most; without
+// this, ShouldBindJSON will happily buffer an arbitrarily large body in
+// server memory before the sandbox or rate limiter ever gets involved.
+const maxBodyBytes = 64 << 10
+
+// Handler returns the POST /api/run Gin handler: it rate-limits by
+// client IP, decodes the request body, and runs the snippet through Run.
+func Handler() gin.HandlerFunc {
+	limiter := NewRateLimiter(rate.Every(time.Second), 5)
+
+	return func(c *gin.Context) {
+		if !limiter.Allow(ClientIP(c.Request)) {
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "rate limit exceeded, slow down"})
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBodyBytes)
+
+		var req Request
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		result, err := Run(c.Request.Context(), req)
+		if err != nil {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, result)
+	}
+}
@@ -0,0 +1,128 @@
+// Package playground implements the "Run" button behind the tutorial's
+// inline Go snippets: it executes a submitted snippet in an isolated
+// temp directory via `go run`, bounded by a timeout, CPU-time and
+// memory rlimits, and a strict import allowlist, and returns its
+// output.
+package playground
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Request is the body of POST /api/run.
+type Request struct {
+	Code  string `json:"code"`
+	Stdin string `json:"stdin"`
+}
+
+// Result is the response body of POST /api/run.
+type Result struct {
+	Stdout   string `json:"stdout"`
+	Stderr   string `json:"stderr"`
+	Exit     int    `json:"exit"`
+	Duration string `json:"duration"`
+}
+
+// AllowedImports is the set of packages a submitted snippet may import.
+// Anything else is rejected before a sandbox is ever started.
+var AllowedImports = map[string]bool{
+	"fmt":     true,
+	"math":    true,
+	"strings": true,
+	"strconv": true,
+	"time":    true,
+	"sort":    true,
+	"errors":  true,
+}
+
+const runTimeout = 5 * time.Second
+
+// Run executes req.Code as a standalone Go program in a fresh temp
+// directory and returns its output. The caller is responsible for rate
+// limiting; Run only bounds a single execution's time, CPU, memory, and
+// import surface.
+func Run(ctx context.Context, req Request) (Result, error) {
+	if err := checkImports(req.Code); err != nil {
+		return Result{}, err
+	}
+
+	dir, err := os.MkdirTemp("", "howtogo-playground-*")
+	if err != nil {
+		return Result{}, fmt.Errorf("playground: creating sandbox dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "main.go")
+	if err := os.WriteFile(src, []byte(req.Code), 0o600); err != nil {
+		return Result{}, fmt.Errorf("playground: writing snippet: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, runTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "go", "run", src)
+	cmd.Dir = dir
+	cmd.Stdin = strings.NewReader(req.Stdin)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	configureSandbox(cmd)
+	start := time.Now()
+	if err := cmd.Start(); err != nil {
+		return Result{}, fmt.Errorf("playground: starting sandbox: %w", err)
+	}
+	if err := limitChildCPU(cmd.Process.Pid); err != nil {
+		log.Printf("playground: limiting sandbox CPU: %v", err)
+	}
+	if err := limitChildMemory(cmd.Process.Pid); err != nil {
+		log.Printf("playground: limiting sandbox memory: %v", err)
+	}
+	runErr := cmd.Wait()
+	duration := time.Since(start)
+
+	exitCode := 0
+	if runErr != nil {
+		if exitErr, ok := runErr.(*exec.ExitError); ok {
+			exitCode = exitErr.ExitCode()
+		} else {
+			exitCode = -1
+			stderr.WriteString(runErr.Error())
+		}
+	}
+
+	return Result{
+		Stdout:   stdout.String(),
+		Stderr:   stderr.String(),
+		Exit:     exitCode,
+		Duration: duration.String(),
+	}, nil
+}
+
+// checkImports rejects any import not in AllowedImports before a
+// snippet is ever handed to go run.
+func checkImports(code string) error {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "snippet.go", code, parser.ImportsOnly)
+	if err != nil {
+		return fmt.Errorf("playground: parsing snippet: %w", err)
+	}
+	for _, imp := range f.Imports {
+		path := strings.Trim(imp.Path.Value, `"`)
+		if !AllowedImports[path] {
+			return fmt.Errorf("playground: import %q is not allowed", path)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package playground
+
+import "testing"
+
+func TestCheckImportsAllowsAllowlisted(t *testing.T) {
+	code := `package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+func main() {
+	fmt.Println(strings.ToUpper("hi"))
+}
+`
+	if err := checkImports(code); err != nil {
+		t.Fatalf("checkImports rejected allowlisted imports: %v", err)
+	}
+}
+
+func TestCheckImportsRejectsDisallowed(t *testing.T) {
+	code := `package main
+
+import "os"
+
+func main() {
+	os.Exit(1)
+}
+`
+	if err := checkImports(code); err == nil {
+		t.Fatal("checkImports accepted a disallowed import")
+	}
+}
+
+func TestCheckImportsRejectsUnparseable(t *testing.T) {
+	if err := checkImports("this is not valid go"); err == nil {
+		t.Fatal("checkImports accepted unparseable source")
+	}
+}
@@ -0,0 +1,98 @@
+package playground
+
+import (
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// staleAfter is how long an IP's limiter is kept after its last request
+// before the cleanup sweep evicts it, so the limiters map doesn't grow
+// without bound as a public endpoint sees more and more distinct IPs.
+const staleAfter = 10 * time.Minute
+
+type limiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter caps how often a single client IP may hit POST /api/run.
+type RateLimiter struct {
+	mu       sync.Mutex
+	limiters map[string]*limiterEntry
+	r        rate.Limit
+	burst    int
+	done     chan struct{}
+}
+
+// NewRateLimiter allows burst requests immediately, then one every
+// 1/r seconds, per client IP. It also starts a background sweep that
+// evicts any IP that hasn't been seen in staleAfter; call Close to stop
+// the sweep once the limiter is no longer needed.
+func NewRateLimiter(r rate.Limit, burst int) *RateLimiter {
+	rl := &RateLimiter{
+		limiters: make(map[string]*limiterEntry),
+		r:        r,
+		burst:    burst,
+		done:     make(chan struct{}),
+	}
+	go rl.cleanupLoop()
+	return rl
+}
+
+// Allow reports whether a request from ip may proceed, creating a fresh
+// limiter for IPs it hasn't seen before.
+func (rl *RateLimiter) Allow(ip string) bool {
+	rl.mu.Lock()
+	e, ok := rl.limiters[ip]
+	if !ok {
+		e = &limiterEntry{limiter: rate.NewLimiter(rl.r, rl.burst)}
+		rl.limiters[ip] = e
+	}
+	e.lastSeen = time.Now()
+	allowed := e.limiter.Allow()
+	rl.mu.Unlock()
+	return allowed
+}
+
+// Close stops the background cleanup sweep.
+func (rl *RateLimiter) Close() {
+	close(rl.done)
+}
+
+func (rl *RateLimiter) cleanupLoop() {
+	ticker := time.NewTicker(staleAfter)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			rl.evictStale(time.Now())
+		case <-rl.done:
+			return
+		}
+	}
+}
+
+// evictStale removes every limiter whose IP hasn't been seen since
+// before now.Add(-staleAfter).
+func (rl *RateLimiter) evictStale(now time.Time) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	for ip, e := range rl.limiters {
+		if now.Sub(e.lastSeen) > staleAfter {
+			delete(rl.limiters, ip)
+		}
+	}
+}
+
+// ClientIP extracts the request's remote IP, stripping any port.
+func ClientIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
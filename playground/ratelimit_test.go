@@ -0,0 +1,50 @@
+package playground
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimiterAllowsBurstThenLimits(t *testing.T) {
+	rl := NewRateLimiter(rate.Every(time.Minute), 2)
+	defer rl.Close()
+
+	if !rl.Allow("1.2.3.4") {
+		t.Fatal("first request should be allowed")
+	}
+	if !rl.Allow("1.2.3.4") {
+		t.Fatal("second request within burst should be allowed")
+	}
+	if rl.Allow("1.2.3.4") {
+		t.Fatal("third request beyond burst should be denied")
+	}
+}
+
+func TestRateLimiterTracksIPsIndependently(t *testing.T) {
+	rl := NewRateLimiter(rate.Every(time.Minute), 1)
+	defer rl.Close()
+
+	if !rl.Allow("1.1.1.1") {
+		t.Fatal("first IP's first request should be allowed")
+	}
+	if !rl.Allow("2.2.2.2") {
+		t.Fatal("second IP's first request should be allowed")
+	}
+}
+
+func TestRateLimiterEvictsStaleEntries(t *testing.T) {
+	rl := NewRateLimiter(rate.Every(time.Minute), 1)
+	defer rl.Close()
+
+	rl.Allow("1.2.3.4")
+	if len(rl.limiters) != 1 {
+		t.Fatalf("expected 1 tracked IP, got %d", len(rl.limiters))
+	}
+
+	rl.evictStale(time.Now().Add(staleAfter + time.Second))
+	if len(rl.limiters) != 0 {
+		t.Fatalf("expected stale IP to be evicted, got %d remaining", len(rl.limiters))
+	}
+}
@@ -0,0 +1,49 @@
+//go:build linux
+
+package playground
+
+import (
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// cpuLimitSeconds bounds how much CPU time a snippet may consume, on
+// top of the wall-clock timeout context.WithTimeout already applies in
+// Run.
+const cpuLimitSeconds = 5
+
+// memLimitBytes bounds a snippet's address space, so a pure-language
+// allocation loop (no disallowed import required) can't OOM the host
+// instead of just hitting its own limit.
+const memLimitBytes = 256 << 20
+
+// configureSandbox puts cmd in its own process group. The rlimits that
+// limitChildCPU and limitChildMemory set apply to cmd's pid alone;
+// processes go run spawns after those calls (the compiler, the built
+// snippet) inherit them on fork.
+func configureSandbox(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// limitChildCPU caps RLIMIT_CPU on the already-started child identified
+// by pid, via prlimit(2). syscall.Setrlimit only ever applies to the
+// calling process, so using it here would have capped the server's own
+// CPU budget and raced against every other concurrent request; unix.
+// Prlimit takes an explicit pid, so it bounds the child alone and never
+// touches the server process's limits.
+func limitChildCPU(pid int) error {
+	limit := unix.Rlimit{Cur: cpuLimitSeconds, Max: cpuLimitSeconds}
+	return unix.Prlimit(pid, unix.RLIMIT_CPU, &limit, nil)
+}
+
+// limitChildMemory caps RLIMIT_AS on the already-started child
+// identified by pid, the same way limitChildCPU caps RLIMIT_CPU. The
+// CPU limit alone bounds runtime but not allocation: a snippet built
+// entirely out of AllowedImports can still loop appending to a slice
+// and exhaust host memory within that CPU budget.
+func limitChildMemory(pid int) error {
+	limit := unix.Rlimit{Cur: memLimitBytes, Max: memLimitBytes}
+	return unix.Prlimit(pid, unix.RLIMIT_AS, &limit, nil)
+}
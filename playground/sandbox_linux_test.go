@@ -0,0 +1,34 @@
+//go:build linux
+
+package playground
+
+import (
+	"os/exec"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestLimitChildMemoryCapsAddressSpace(t *testing.T) {
+	cmd := exec.Command("sleep", "5")
+	configureSandbox(cmd)
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("starting child: %v", err)
+	}
+	defer func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}()
+
+	if err := limitChildMemory(cmd.Process.Pid); err != nil {
+		t.Fatalf("limitChildMemory: %v", err)
+	}
+
+	var got unix.Rlimit
+	if err := unix.Prlimit(cmd.Process.Pid, unix.RLIMIT_AS, nil, &got); err != nil {
+		t.Fatalf("reading RLIMIT_AS: %v", err)
+	}
+	if got.Cur != memLimitBytes || got.Max != memLimitBytes {
+		t.Errorf("RLIMIT_AS = {Cur: %d, Max: %d}, want %d", got.Cur, got.Max, memLimitBytes)
+	}
+}
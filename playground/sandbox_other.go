@@ -0,0 +1,21 @@
+//go:build !linux
+
+package playground
+
+import "os/exec"
+
+// configureSandbox is a no-op on non-Linux platforms, where process
+// groups and RLIMIT_CPU semantics differ or are unavailable. The
+// context.WithTimeout deadline in Run remains the primary bound
+// everywhere.
+func configureSandbox(cmd *exec.Cmd) {}
+
+// limitChildCPU is a no-op on non-Linux platforms; see configureSandbox.
+func limitChildCPU(pid int) error {
+	return nil
+}
+
+// limitChildMemory is a no-op on non-Linux platforms; see configureSandbox.
+func limitChildMemory(pid int) error {
+	return nil
+}
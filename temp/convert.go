@@ -1,45 +1,43 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
-	"os/exec"
-	"path/filepath"
-	"strings"
+
+	"github.com/whalelogic/howtogo/converter"
 )
 
 func main() {
+	converterName := flag.String("converter", "goldmark", "converter to use: goldmark or pandoc")
+	srcDir := flag.String("src", ".", "directory to scan for .md files")
+	outDir := flag.String("out", "html", "directory to write rendered .html files to")
+	workers := flag.Int("workers", 4, "number of concurrent conversion workers")
+	flag.Parse()
 
-	file, err := os.ReadDir(".")
+	conv, err := converter.New(*converterName)
 	if err != nil {
-		fmt.Println("Error reading directory:", err)
-		return
+		fmt.Println("Error:", err)
+		os.Exit(1)
 	}
 
-	outputDir := "html"
-	if err := os.MkdirAll(outputDir, 0755); err != nil {
-		fmt.Println("Error creating output directory:", err)
-		return
+	jobs, err := converter.WalkDir(*srcDir, *outDir)
+	if err != nil {
+		fmt.Println("Error reading directory:", err)
+		os.Exit(1)
 	}
 
-	for _, f := range file {
-		fmt.Println(f.Name())
-		if !f.IsDir() && strings.HasSuffix(f.Name(), ".md") {
-			inputName := f.Name()
-			outputName := strings.TrimSuffix(inputName, ".md") + ".html"
-
-			src := filepath.Clean(inputName)
-			dst := filepath.Join(outputDir, outputName)
-
-			cmd := exec.Command("pandoc", src, "-o", dst)
-			if err := cmd.Run(); err != nil {
-				fmt.Printf("Error converting %s to %s: %v\n", inputName, outputName, err)
-			} else {
-				fmt.Printf("Converted %s to %s.\n", inputName, dst)
+	results := converter.Run(conv, jobs, *workers)
 
-			}
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+			fmt.Printf("Error converting %s: %v\n", r.Job.Src, r.Err)
+			continue
 		}
+		fmt.Printf("Converted %s to %s.\n", r.Job.Src, r.Job.Dst)
 	}
 
-	fmt.Println("Done.")
+	fmt.Printf("Done. %d converted, %d failed.\n", len(results)-failed, failed)
 }
@@ -0,0 +1,22 @@
+package pages
+
+import "github.com/a-h/templ"
+
+// Entry pairs a self-registered page's default title with its component.
+type Entry struct {
+	Title     string
+	Component templ.Component
+}
+
+// Registry maps a page's slug to the Entry it registered itself with in
+// its own init(). content.Load reads this directly, so dropping in a
+// new templates/pages/*.templ file that calls Register is enough on its
+// own to add a lesson — content.yaml only needs touching if you want to
+// pin its title or its place in the reading order.
+var Registry = map[string]Entry{}
+
+// Register adds slug to Registry. Pages call this from their own
+// init() rather than being wired up in main.go.
+func Register(slug, title string, component templ.Component) {
+	Registry[slug] = Entry{Title: title, Component: component}
+}